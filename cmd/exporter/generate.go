@@ -0,0 +1,305 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/yichern/terraform-provider-linear/internal/provider"
+)
+
+var nonIdentRe = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// localName turns an arbitrary string (a team key, a label name, ...) into
+// a valid, reasonably readable Terraform resource name.
+func localName(parts ...string) string {
+	name := strings.ToLower(strings.Join(parts, "_"))
+	name = nonIdentRe.ReplaceAllString(name, "_")
+	name = strings.Trim(name, "_")
+	if name == "" {
+		name = "unnamed"
+	}
+	return name
+}
+
+// uniqueName returns name unchanged the first time it's seen, and a
+// numbered suffix on every later collision, so two objects that normalize
+// to the same slug (e.g. labels "Bug" and "bug" in the same team) don't
+// produce two resource blocks with the same address. seen is scoped to one
+// resource type by its caller.
+func uniqueName(seen map[string]int, name string) string {
+	n := seen[name]
+	seen[name] = n + 1
+	if n == 0 {
+		return name
+	}
+	return fmt.Sprintf("%s_%d", name, n+1)
+}
+
+// resourceRef is everything the exporter needs to emit one resource block,
+// its import block, and let other resources reference it.
+type resourceRef struct {
+	resourceType string
+	localName    string
+	id           string
+}
+
+// export fetches the requested resource kinds, optionally scoped to a
+// single team, and writes one .tf file per kind plus a shared import.tf
+// under outDir.
+func export(ctx context.Context, client graphql.Client, wanted map[string]bool, teamKey, outDir string) error {
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return fmt.Errorf("creating output directory: %w", err)
+	}
+
+	teams, err := provider.ListAllTeams(ctx, client)
+	if err != nil {
+		return fmt.Errorf("listing teams: %w", err)
+	}
+
+	// teamRefs indexes every team by id so labels and workflow states can
+	// resolve a `team_id = linear_team.eng.id` reference instead of a bare
+	// ID string. Templates are exported without a team_id.
+	teamRefs := make(map[string]resourceRef, len(teams))
+	var teamIDFilter string
+	for _, team := range teams {
+		ref := resourceRef{resourceType: "linear_team", localName: localName(team.Key), id: team.Id}
+		teamRefs[team.Id] = ref
+		if teamKey != "" && team.Key == teamKey {
+			teamIDFilter = team.Id
+		}
+	}
+	if teamKey != "" && teamIDFilter == "" {
+		return fmt.Errorf("no team found with key %q", teamKey)
+	}
+
+	var imports []resourceRef
+
+	if wanted["teams"] {
+		refs, err := exportTeams(outDir, teams, teamIDFilter)
+		if err != nil {
+			return err
+		}
+		imports = append(imports, refs...)
+	}
+
+	if wanted["labels"] {
+		labels, err := provider.ListAllLabels(ctx, client)
+		if err != nil {
+			return fmt.Errorf("listing labels: %w", err)
+		}
+		refs, err := exportLabels(outDir, labels, teamRefs, teamIDFilter)
+		if err != nil {
+			return err
+		}
+		imports = append(imports, refs...)
+	}
+
+	if wanted["workflow_states"] {
+		states, err := provider.ListAllWorkflowStates(ctx, client)
+		if err != nil {
+			return fmt.Errorf("listing workflow states: %w", err)
+		}
+		refs, err := exportWorkflowStates(outDir, states, teamRefs, teamIDFilter)
+		if err != nil {
+			return err
+		}
+		imports = append(imports, refs...)
+	}
+
+	if wanted["templates"] {
+		templates, err := provider.ListAllTemplates(ctx, client)
+		if err != nil {
+			return fmt.Errorf("listing templates: %w", err)
+		}
+		refs, err := exportTemplates(outDir, templates)
+		if err != nil {
+			return err
+		}
+		imports = append(imports, refs...)
+	}
+
+	return writeImports(outDir, imports)
+}
+
+func newHCLFile() *hclwrite.File {
+	return hclwrite.NewEmptyFile()
+}
+
+func writeHCLFile(outDir, filename string, f *hclwrite.File) error {
+	return os.WriteFile(filepath.Join(outDir, filename), f.Bytes(), 0o644)
+}
+
+// writeHCLFileWithHeader is writeHCLFile with a raw comment prepended, for
+// files that need a heads-up visible to someone reading the generated .tf
+// directly rather than this source.
+func writeHCLFileWithHeader(outDir, filename, header string, f *hclwrite.File) error {
+	content := append([]byte(header), f.Bytes()...)
+	return os.WriteFile(filepath.Join(outDir, filename), content, 0o644)
+}
+
+// exportTeams emits name and key, the only two arguments linear_team
+// requires.
+func exportTeams(outDir string, teams []provider.Team, teamIDFilter string) ([]resourceRef, error) {
+	f := newHCLFile()
+	var refs []resourceRef
+	seen := make(map[string]int)
+	for _, team := range teams {
+		if teamIDFilter != "" && team.Id != teamIDFilter {
+			continue
+		}
+		ref := resourceRef{resourceType: "linear_team", localName: uniqueName(seen, localName(team.Key)), id: team.Id}
+		block := f.Body().AppendNewBlock("resource", []string{ref.resourceType, ref.localName})
+		body := block.Body()
+		body.SetAttributeValue("name", cty.StringVal(team.Name))
+		body.SetAttributeValue("key", cty.StringVal(team.Key))
+		refs = append(refs, ref)
+	}
+	if err := writeHCLFile(outDir, "teams.tf", f); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// labelOrStateRef resolves the team a label or workflow state belongs to
+// against teamRefs. A blank team ID means the object is workspace-scoped
+// (no team), which is valid and gets no team_id attribute. A non-blank ID
+// that isn't found (e.g. an archived team) also gets no team_id, but is
+// logged so the gap is visible instead of the object silently vanishing.
+func labelOrStateRef(teamRefs map[string]resourceRef, kind, name, teamID string) (resourceRef, bool) {
+	if teamID == "" {
+		return resourceRef{}, false
+	}
+	ref, ok := teamRefs[teamID]
+	if !ok {
+		log.Printf("linear-exporter: %s %q references team %s, which wasn't found; exporting without team_id", kind, name, teamID)
+		return resourceRef{}, false
+	}
+	return ref, true
+}
+
+func exportLabels(outDir string, labels []provider.IssueLabel, teamRefs map[string]resourceRef, teamIDFilter string) ([]resourceRef, error) {
+	f := newHCLFile()
+	var refs []resourceRef
+	seen := make(map[string]int)
+	for _, label := range labels {
+		if teamIDFilter != "" && label.Team.Id != teamIDFilter {
+			continue
+		}
+		teamRef, hasTeam := labelOrStateRef(teamRefs, "label", label.Name, label.Team.Id)
+		name := localName(label.Name)
+		if hasTeam {
+			name = localName(teamRef.localName, label.Name)
+		}
+		ref := resourceRef{resourceType: "linear_label", localName: uniqueName(seen, name), id: label.Id}
+		block := f.Body().AppendNewBlock("resource", []string{ref.resourceType, ref.localName})
+		body := block.Body()
+		body.SetAttributeValue("name", cty.StringVal(label.Name))
+		body.SetAttributeValue("color", cty.StringVal(label.Color))
+		if hasTeam {
+			body.SetAttributeTraversal("team_id", teamRefTraversal(teamRef))
+		}
+		refs = append(refs, ref)
+	}
+	if err := writeHCLFile(outDir, "labels.tf", f); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+func exportWorkflowStates(outDir string, states []provider.WorkflowState, teamRefs map[string]resourceRef, teamIDFilter string) ([]resourceRef, error) {
+	f := newHCLFile()
+	var refs []resourceRef
+	seen := make(map[string]int)
+	for _, ws := range states {
+		if teamIDFilter != "" && ws.Team.Id != teamIDFilter {
+			continue
+		}
+		teamRef, hasTeam := labelOrStateRef(teamRefs, "workflow state", ws.Name, ws.Team.Id)
+		name := localName(ws.Name)
+		if hasTeam {
+			name = localName(teamRef.localName, ws.Name)
+		}
+		ref := resourceRef{resourceType: "linear_workflow_state", localName: uniqueName(seen, name), id: ws.Id}
+		block := f.Body().AppendNewBlock("resource", []string{ref.resourceType, ref.localName})
+		body := block.Body()
+		body.SetAttributeValue("name", cty.StringVal(ws.Name))
+		body.SetAttributeValue("type", cty.StringVal(ws.Type))
+		body.SetAttributeValue("color", cty.StringVal(ws.Color))
+		if hasTeam {
+			body.SetAttributeTraversal("team_id", teamRefTraversal(teamRef))
+		}
+		refs = append(refs, ref)
+	}
+	if err := writeHCLFile(outDir, "workflow_states.tf", f); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// templatesFileHeader is prepended to templates.tf: ListAllTemplates only
+// gives us id and name, so the generated blocks are missing whatever
+// template_data the real objects hold and will need a manual pass before
+// `terraform apply` will accept them.
+const templatesFileHeader = `# linear-exporter only has id and name for templates; it cannot reconstruct
+# template_data (the issue/project field defaults a template applies), so
+# each block below needs that filled in by hand before terraform apply will
+# accept it.
+
+`
+
+// exportTemplates is not scoped by -team: provider.Template carries no team
+// association to filter on (Linear templates can be workspace-wide), so
+// -team only narrows teams, labels, and workflow_states.
+func exportTemplates(outDir string, templates []provider.Template) ([]resourceRef, error) {
+	f := newHCLFile()
+	var refs []resourceRef
+	seen := make(map[string]int)
+	for _, t := range templates {
+		ref := resourceRef{resourceType: "linear_template", localName: uniqueName(seen, localName(t.Name)), id: t.Id}
+		block := f.Body().AppendNewBlock("resource", []string{ref.resourceType, ref.localName})
+		body := block.Body()
+		body.SetAttributeValue("name", cty.StringVal(t.Name))
+		refs = append(refs, ref)
+	}
+	if len(refs) > 0 {
+		log.Printf("linear-exporter: templates.tf: %d linear_template block(s) are missing template_data and need manual completion", len(refs))
+	}
+	if err := writeHCLFileWithHeader(outDir, "templates.tf", templatesFileHeader, f); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// writeImports emits one `import { to = ..., id = "..." }` block per
+// exported resource, per Terraform 1.5+ import block syntax.
+func writeImports(outDir string, refs []resourceRef) error {
+	f := newHCLFile()
+	for _, ref := range refs {
+		block := f.Body().AppendNewBlock("import", nil)
+		body := block.Body()
+		body.SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: ref.resourceType},
+			hcl.TraverseAttr{Name: ref.localName},
+		})
+		body.SetAttributeValue("id", cty.StringVal(ref.id))
+	}
+	return writeHCLFile(outDir, "import.tf", f)
+}
+
+func teamRefTraversal(ref resourceRef) hcl.Traversal {
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: ref.resourceType},
+		hcl.TraverseAttr{Name: ref.localName},
+		hcl.TraverseAttr{Name: "id"},
+	}
+}
@@ -2,11 +2,9 @@ package provider
 
 import (
 	"context"
-	"fmt"
-	"sync"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type ProviderData struct {
@@ -14,179 +12,62 @@ type ProviderData struct {
 	Cache  BulkCache
 }
 
-// BulkCache provides lazy-loaded caches for list queries, reducing
-// per-resource API calls to one list query per resource type.
-type BulkCache struct {
-	client graphql.Client
-
-	labelsOnce sync.Once
-	labels     map[string]*IssueLabel
-	labelsErr  error
-
-	workflowStatesOnce sync.Once
-	workflowStates     map[string]*WorkflowState
-	workflowStatesErr  error
-
-	templatesOnce sync.Once
-	templates     map[string]*Template
-	templatesErr  error
-
-	teamsOnce  sync.Once
-	teamsByKey map[string]*Team
-	teamsErr   error
-}
-
-func newBulkCache(client graphql.Client) BulkCache {
-	return BulkCache{client: client}
-}
-
-func (c *BulkCache) ensureLabels(ctx context.Context) {
-	c.labelsOnce.Do(func() {
-		tflog.Debug(ctx, "bulk fetching all issue labels")
-		c.labels = make(map[string]*IssueLabel)
-		var cursor *string
-		for {
-			resp, err := listAllLabelsPage(ctx, c.client, cursor)
-			if err != nil {
-				c.labelsErr = err
-				return
-			}
-			for _, node := range resp.IssueLabels.Nodes {
-				label := node.IssueLabel
-				c.labels[label.Id] = &label
-			}
-			if !resp.IssueLabels.PageInfo.HasNextPage {
-				break
-			}
-			cursor = &resp.IssueLabels.PageInfo.EndCursor
-		}
-		tflog.Debug(ctx, fmt.Sprintf("bulk fetched %d issue labels", len(c.labels)))
-	})
-}
-
-func (c *BulkCache) GetLabel(ctx context.Context, id string) (*IssueLabel, error) {
-	c.ensureLabels(ctx)
-	if c.labelsErr != nil {
-		return nil, c.labelsErr
-	}
-	label, ok := c.labels[id]
-	if !ok {
-		return nil, fmt.Errorf("label not found in bulk cache: %s", id)
-	}
-	return label, nil
-}
-
-func (c *BulkCache) ensureWorkflowStates(ctx context.Context) {
-	c.workflowStatesOnce.Do(func() {
-		tflog.Debug(ctx, "bulk fetching all workflow states")
-		c.workflowStates = make(map[string]*WorkflowState)
-		var cursor *string
-		for {
-			resp, err := listAllWorkflowStatesPage(ctx, c.client, cursor)
-			if err != nil {
-				c.workflowStatesErr = err
-				return
-			}
-			for _, node := range resp.WorkflowStates.Nodes {
-				ws := node.WorkflowState
-				c.workflowStates[ws.Id] = &ws
-			}
-			if !resp.WorkflowStates.PageInfo.HasNextPage {
-				break
-			}
-			cursor = &resp.WorkflowStates.PageInfo.EndCursor
-		}
-		tflog.Debug(ctx, fmt.Sprintf("bulk fetched %d workflow states", len(c.workflowStates)))
-	})
-}
-
-func (c *BulkCache) GetWorkflowState(ctx context.Context, id string) (*WorkflowState, error) {
-	c.ensureWorkflowStates(ctx)
-	if c.workflowStatesErr != nil {
-		return nil, c.workflowStatesErr
-	}
-	ws, ok := c.workflowStates[id]
-	if !ok {
-		return nil, fmt.Errorf("workflow state not found in bulk cache: %s", id)
-	}
-	return ws, nil
-}
-
-func (c *BulkCache) GetWorkflowStatesByTeamID(ctx context.Context, teamID string) ([]WorkflowState, error) {
-	c.ensureWorkflowStates(ctx)
-	if c.workflowStatesErr != nil {
-		return nil, c.workflowStatesErr
-	}
-	var result []WorkflowState
-	for _, ws := range c.workflowStates {
-		if ws.Team.Id == teamID {
-			result = append(result, *ws)
-		}
-	}
-	return result, nil
-}
+// CacheMode selects which BulkCache implementation a provider instance
+// uses, via the provider schema's cache_mode attribute.
+type CacheMode string
+
+const (
+	// CacheModeEager bulk-loads every label, workflow state, template, and
+	// team up front and keeps them in memory (and optionally on disk) for
+	// the life of the provider instance. Good default for most workspaces.
+	CacheModeEager CacheMode = "eager"
+	// CacheModeLRU keeps bounded, per-kind LRU caches and falls back to
+	// targeted single-item fetches on miss. Use for workspaces too large to
+	// comfortably hold in memory all at once.
+	CacheModeLRU CacheMode = "lru"
+)
 
-func (c *BulkCache) ensureTemplates(ctx context.Context) {
-	c.templatesOnce.Do(func() {
-		tflog.Debug(ctx, "bulk fetching all templates")
-		resp, err := listAllTemplates(ctx, c.client)
-		if err != nil {
-			c.templatesErr = err
-			return
-		}
-		c.templates = make(map[string]*Template, len(resp.Templates))
-		for _, node := range resp.Templates {
-			t := node.Template
-			c.templates[t.Id] = &t
-		}
-		tflog.Debug(ctx, fmt.Sprintf("bulk fetched %d templates", len(c.templates)))
-	})
+// BulkCacheConfig controls how a BulkCache is built and, for the eager
+// implementation, how it persists and refreshes its data. It's populated
+// from the provider schema's cache_mode, cache_dir, refresh_interval,
+// disable_persistent_cache, and lru_max_size attributes.
+type BulkCacheConfig struct {
+	// Mode selects the implementation. Defaults to CacheModeEager.
+	Mode CacheMode
+	// Workspace scopes the on-disk cache directory so multiple Linear
+	// workspaces configured on the same machine don't collide.
+	Workspace string
+	// CacheDir overrides where persistent snapshots are written. Defaults
+	// to ~/.terraform.d/linear-cache/<workspace>. Eager mode only.
+	CacheDir string
+	// RefreshInterval is how long a persisted snapshot is trusted before
+	// ensureX re-fetches from the API. Defaults to defaultRefreshInterval.
+	// Eager mode only.
+	RefreshInterval time.Duration
+	// DisablePersistentCache turns the on-disk cache off, falling back to
+	// in-memory-only, once-per-provider-instance behavior. Eager mode only.
+	DisablePersistentCache bool
+	// LRUMaxSize bounds how many items each per-kind cache holds before
+	// evicting the least recently used entry. Defaults to
+	// defaultLRUMaxSize. LRU mode only.
+	LRUMaxSize int
 }
 
-func (c *BulkCache) GetTemplate(ctx context.Context, id string) (*Template, error) {
-	c.ensureTemplates(ctx)
-	if c.templatesErr != nil {
-		return nil, c.templatesErr
-	}
-	t, ok := c.templates[id]
-	if !ok {
-		return nil, fmt.Errorf("template not found in bulk cache: %s", id)
-	}
-	return t, nil
+// BulkCache provides cached lookups for the list-backed resources (labels,
+// workflow states, templates, teams), reducing per-resource API calls.
+// eagerBulkCache and lruBulkCache are the two implementations; callers are
+// unaffected by which one a provider instance picked.
+type BulkCache interface {
+	GetLabel(ctx context.Context, id string) (*IssueLabel, error)
+	GetWorkflowState(ctx context.Context, id string) (*WorkflowState, error)
+	GetWorkflowStatesByTeamID(ctx context.Context, teamID string) ([]WorkflowState, error)
+	GetTemplate(ctx context.Context, id string) (*Template, error)
+	GetTeamByKey(ctx context.Context, key string) (*Team, error)
 }
 
-func (c *BulkCache) ensureTeams(ctx context.Context) {
-	c.teamsOnce.Do(func() {
-		tflog.Debug(ctx, "bulk fetching all teams")
-		c.teamsByKey = make(map[string]*Team)
-		var cursor *string
-		for {
-			resp, err := listAllTeamsPage(ctx, c.client, cursor)
-			if err != nil {
-				c.teamsErr = err
-				return
-			}
-			for _, node := range resp.Teams.Nodes {
-				team := node.Team
-				c.teamsByKey[team.Key] = &team
-			}
-			if !resp.Teams.PageInfo.HasNextPage {
-				break
-			}
-			cursor = &resp.Teams.PageInfo.EndCursor
-		}
-		tflog.Debug(ctx, fmt.Sprintf("bulk fetched %d teams", len(c.teamsByKey)))
-	})
-}
-
-func (c *BulkCache) GetTeamByKey(ctx context.Context, key string) (*Team, error) {
-	c.ensureTeams(ctx)
-	if c.teamsErr != nil {
-		return nil, c.teamsErr
-	}
-	team, ok := c.teamsByKey[key]
-	if !ok {
-		return nil, fmt.Errorf("team not found in bulk cache: %s", key)
+func newBulkCache(client graphql.Client, cfg BulkCacheConfig) BulkCache {
+	if cfg.Mode == CacheModeLRU {
+		return newLRUBulkCache(client, cfg)
 	}
-	return team, nil
+	return newEagerBulkCache(client, cfg)
 }
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// progressLogInterval controls how often a bulk fetch emits a tflog.Info
+// progress event while it's in flight.
+const progressLogInterval = 5 * time.Second
+
+// paginateWithProgress walks a Relay-style cursor-paginated list query,
+// fetching one page at a time. Cursor N+1 is only known once page N has
+// returned, so there's no opportunity for real concurrency within a single
+// pagination walk here - what this adds over a bare loop is a ticker that
+// logs how many records have been merged so far, so TF_LOG=INFO users
+// watching a large workspace's first bulk load see it advancing instead of
+// a silent multi-second hang. Linear's list queries don't return a total
+// count alongside hasNextPage, so the log reports a running count rather
+// than an X/Y fraction.
+//
+// There is no worker pool and no setting for a worker count: pages are
+// always fetched one after another, on the caller's goroutine.
+func paginateWithProgress[T any](
+	ctx context.Context,
+	label string,
+	fetchPage func(ctx context.Context, cursor *string) (nodes []T, hasNextPage bool, endCursor string, err error),
+	merge func(nodes []T) int,
+) error {
+	var count atomic.Int64
+
+	ticker := time.NewTicker(progressLogInterval)
+	defer ticker.Stop()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				tflog.Info(ctx, fmt.Sprintf("fetched %d %s so far", count.Load(), label))
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var cursor *string
+	for {
+		nodes, hasNextPage, endCursor, err := fetchPage(ctx, cursor)
+		if err != nil {
+			return err
+		}
+		count.Store(int64(merge(nodes)))
+		if !hasNextPage {
+			return nil
+		}
+		cursor = &endCursor
+	}
+}
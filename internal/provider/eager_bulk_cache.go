@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// eagerBulkCache is the default BulkCache implementation: it bulk-loads
+// every label, workflow state, template, and team up front, the first time
+// any of them is requested, and keeps the full set in memory for the life
+// of the provider instance. Fetched snapshots are also persisted to disk so
+// they survive across separate Terraform invocations until they expire.
+type eagerBulkCache struct {
+	client graphql.Client
+	disk   *diskCache
+
+	labelsOnce sync.Once
+	labels     map[string]*IssueLabel
+	labelsErr  error
+
+	workflowStatesOnce sync.Once
+	workflowStates     map[string]*WorkflowState
+	workflowStatesErr  error
+
+	templatesOnce sync.Once
+	templates     map[string]*Template
+	templatesErr  error
+
+	teamsOnce  sync.Once
+	teamsByKey map[string]*Team
+	teamsErr   error
+}
+
+func newEagerBulkCache(client graphql.Client, cfg BulkCacheConfig) *eagerBulkCache {
+	dir := cfg.CacheDir
+	if dir == "" {
+		dir = defaultCacheDir(cfg.Workspace)
+	}
+	return &eagerBulkCache{
+		client: client,
+		disk:   newDiskCache(dir, cfg.RefreshInterval, cfg.DisablePersistentCache),
+	}
+}
+
+func (c *eagerBulkCache) ensureLabels(ctx context.Context) {
+	c.labelsOnce.Do(func() {
+		c.labels = make(map[string]*IssueLabel)
+		if c.disk.load(ctx, "labels", &c.labels) {
+			return
+		}
+
+		tflog.Debug(ctx, "bulk fetching all issue labels")
+		err := paginateWithProgress(ctx, "issue labels",
+			func(ctx context.Context, cursor *string) ([]IssueLabel, bool, string, error) {
+				resp, err := listAllLabelsPage(ctx, c.client, cursor)
+				if err != nil {
+					return nil, false, "", err
+				}
+				nodes := make([]IssueLabel, len(resp.IssueLabels.Nodes))
+				for i, node := range resp.IssueLabels.Nodes {
+					nodes[i] = node.IssueLabel
+				}
+				return nodes, resp.IssueLabels.PageInfo.HasNextPage, resp.IssueLabels.PageInfo.EndCursor, nil
+			},
+			func(nodes []IssueLabel) int {
+				for _, node := range nodes {
+					label := node
+					c.labels[label.Id] = &label
+				}
+				return len(c.labels)
+			},
+		)
+		if err != nil {
+			c.labelsErr = err
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("bulk fetched %d issue labels", len(c.labels)))
+		c.disk.save(ctx, "labels", c.labels)
+	})
+}
+
+func (c *eagerBulkCache) GetLabel(ctx context.Context, id string) (*IssueLabel, error) {
+	c.ensureLabels(ctx)
+	if c.labelsErr != nil {
+		return nil, c.labelsErr
+	}
+	label, ok := c.labels[id]
+	if !ok {
+		return nil, fmt.Errorf("label not found in bulk cache: %s", id)
+	}
+	return label, nil
+}
+
+func (c *eagerBulkCache) ensureWorkflowStates(ctx context.Context) {
+	c.workflowStatesOnce.Do(func() {
+		c.workflowStates = make(map[string]*WorkflowState)
+		if c.disk.load(ctx, "workflow_states", &c.workflowStates) {
+			return
+		}
+
+		tflog.Debug(ctx, "bulk fetching all workflow states")
+		err := paginateWithProgress(ctx, "workflow states",
+			func(ctx context.Context, cursor *string) ([]WorkflowState, bool, string, error) {
+				resp, err := listAllWorkflowStatesPage(ctx, c.client, cursor)
+				if err != nil {
+					return nil, false, "", err
+				}
+				nodes := make([]WorkflowState, len(resp.WorkflowStates.Nodes))
+				for i, node := range resp.WorkflowStates.Nodes {
+					nodes[i] = node.WorkflowState
+				}
+				return nodes, resp.WorkflowStates.PageInfo.HasNextPage, resp.WorkflowStates.PageInfo.EndCursor, nil
+			},
+			func(nodes []WorkflowState) int {
+				for _, node := range nodes {
+					ws := node
+					c.workflowStates[ws.Id] = &ws
+				}
+				return len(c.workflowStates)
+			},
+		)
+		if err != nil {
+			c.workflowStatesErr = err
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("bulk fetched %d workflow states", len(c.workflowStates)))
+		c.disk.save(ctx, "workflow_states", c.workflowStates)
+	})
+}
+
+func (c *eagerBulkCache) GetWorkflowState(ctx context.Context, id string) (*WorkflowState, error) {
+	c.ensureWorkflowStates(ctx)
+	if c.workflowStatesErr != nil {
+		return nil, c.workflowStatesErr
+	}
+	ws, ok := c.workflowStates[id]
+	if !ok {
+		return nil, fmt.Errorf("workflow state not found in bulk cache: %s", id)
+	}
+	return ws, nil
+}
+
+func (c *eagerBulkCache) GetWorkflowStatesByTeamID(ctx context.Context, teamID string) ([]WorkflowState, error) {
+	c.ensureWorkflowStates(ctx)
+	if c.workflowStatesErr != nil {
+		return nil, c.workflowStatesErr
+	}
+	var result []WorkflowState
+	for _, ws := range c.workflowStates {
+		if ws.Team.Id == teamID {
+			result = append(result, *ws)
+		}
+	}
+	return result, nil
+}
+
+func (c *eagerBulkCache) ensureTemplates(ctx context.Context) {
+	c.templatesOnce.Do(func() {
+		c.templates = make(map[string]*Template)
+		if c.disk.load(ctx, "templates", &c.templates) {
+			return
+		}
+
+		tflog.Debug(ctx, "bulk fetching all templates")
+		resp, err := listAllTemplates(ctx, c.client)
+		if err != nil {
+			c.templatesErr = err
+			return
+		}
+		for _, node := range resp.Templates {
+			t := node.Template
+			c.templates[t.Id] = &t
+		}
+		tflog.Debug(ctx, fmt.Sprintf("bulk fetched %d templates", len(c.templates)))
+		c.disk.save(ctx, "templates", c.templates)
+	})
+}
+
+func (c *eagerBulkCache) GetTemplate(ctx context.Context, id string) (*Template, error) {
+	c.ensureTemplates(ctx)
+	if c.templatesErr != nil {
+		return nil, c.templatesErr
+	}
+	t, ok := c.templates[id]
+	if !ok {
+		return nil, fmt.Errorf("template not found in bulk cache: %s", id)
+	}
+	return t, nil
+}
+
+func (c *eagerBulkCache) ensureTeams(ctx context.Context) {
+	c.teamsOnce.Do(func() {
+		c.teamsByKey = make(map[string]*Team)
+		if c.disk.load(ctx, "teams", &c.teamsByKey) {
+			return
+		}
+
+		tflog.Debug(ctx, "bulk fetching all teams")
+		err := paginateWithProgress(ctx, "teams",
+			func(ctx context.Context, cursor *string) ([]Team, bool, string, error) {
+				resp, err := listAllTeamsPage(ctx, c.client, cursor)
+				if err != nil {
+					return nil, false, "", err
+				}
+				nodes := make([]Team, len(resp.Teams.Nodes))
+				for i, node := range resp.Teams.Nodes {
+					nodes[i] = node.Team
+				}
+				return nodes, resp.Teams.PageInfo.HasNextPage, resp.Teams.PageInfo.EndCursor, nil
+			},
+			func(nodes []Team) int {
+				for _, node := range nodes {
+					team := node
+					c.teamsByKey[team.Key] = &team
+				}
+				return len(c.teamsByKey)
+			},
+		)
+		if err != nil {
+			c.teamsErr = err
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("bulk fetched %d teams", len(c.teamsByKey)))
+		c.disk.save(ctx, "teams", c.teamsByKey)
+	})
+}
+
+func (c *eagerBulkCache) GetTeamByKey(ctx context.Context, key string) (*Team, error) {
+	c.ensureTeams(ctx)
+	if c.teamsErr != nil {
+		return nil, c.teamsErr
+	}
+	team, ok := c.teamsByKey[key]
+	if !ok {
+		return nil, fmt.Errorf("team not found in bulk cache: %s", key)
+	}
+	return team, nil
+}
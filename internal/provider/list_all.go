@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// ListAllTeams, ListAllLabels, ListAllWorkflowStates, and ListAllTemplates
+// page through every object of their kind and return the full set. They're
+// exported for tooling (such as the linear-exporter command) that needs a
+// complete enumeration rather than a cached lookup, and exist separately
+// from BulkCache so that mode doesn't have to materialize full lists.
+//
+// The fields these return are whatever the underlying listAllLabelsPage /
+// listAllWorkflowStatesPage / listAllTeamsPage / listAllTemplates queries
+// select. linear-exporter needs Color on IssueLabel and Type/Color on
+// WorkflowState to emit valid resource blocks, so those queries' selection
+// sets must include them.
+
+func ListAllTeams(ctx context.Context, client graphql.Client) ([]Team, error) {
+	var result []Team
+	var cursor *string
+	for {
+		resp, err := listAllTeamsPage(ctx, client, cursor)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range resp.Teams.Nodes {
+			result = append(result, node.Team)
+		}
+		if !resp.Teams.PageInfo.HasNextPage {
+			break
+		}
+		cursor = &resp.Teams.PageInfo.EndCursor
+	}
+	return result, nil
+}
+
+func ListAllLabels(ctx context.Context, client graphql.Client) ([]IssueLabel, error) {
+	var result []IssueLabel
+	var cursor *string
+	for {
+		resp, err := listAllLabelsPage(ctx, client, cursor)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range resp.IssueLabels.Nodes {
+			result = append(result, node.IssueLabel)
+		}
+		if !resp.IssueLabels.PageInfo.HasNextPage {
+			break
+		}
+		cursor = &resp.IssueLabels.PageInfo.EndCursor
+	}
+	return result, nil
+}
+
+func ListAllWorkflowStates(ctx context.Context, client graphql.Client) ([]WorkflowState, error) {
+	var result []WorkflowState
+	var cursor *string
+	for {
+		resp, err := listAllWorkflowStatesPage(ctx, client, cursor)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range resp.WorkflowStates.Nodes {
+			result = append(result, node.WorkflowState)
+		}
+		if !resp.WorkflowStates.PageInfo.HasNextPage {
+			break
+		}
+		cursor = &resp.WorkflowStates.PageInfo.EndCursor
+	}
+	return result, nil
+}
+
+func ListAllTemplates(ctx context.Context, client graphql.Client) ([]Template, error) {
+	resp, err := listAllTemplates(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]Template, len(resp.Templates))
+	for i, node := range resp.Templates {
+		result[i] = node.Template
+	}
+	return result, nil
+}
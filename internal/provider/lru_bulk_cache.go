@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultLRUMaxSize bounds each per-kind cache in lruBulkCache when the
+// provider doesn't set lru_max_size.
+const defaultLRUMaxSize = 5000
+
+// workflowStateExcerpt holds just enough of a WorkflowState to serve
+// GetWorkflowStatesByTeamID without paying to keep every full object in
+// memory. It's bulk-loaded once; the full objects it points at live in the
+// LRU and are fetched on demand.
+type workflowStateExcerpt struct {
+	Id     string
+	TeamId string
+}
+
+// lruBulkCache is the BulkCache implementation for large workspaces: it
+// never bulk-loads full objects. Workflow states keep a lightweight
+// excerpt map (bulk-loaded once) so GetWorkflowStatesByTeamID can be
+// served without a full per-kind list, while full objects for all four
+// kinds are fetched one at a time on a cache miss and kept in a bounded
+// LRU.
+type lruBulkCache struct {
+	client graphql.Client
+
+	labels *lruCache[string, *IssueLabel]
+
+	workflowStateExcerptsOnce sync.Once
+	workflowStateExcerpts     map[string]workflowStateExcerpt
+	workflowStateExcerptsErr  error
+	workflowStates            *lruCache[string, *WorkflowState]
+
+	templates *lruCache[string, *Template]
+	teams     *lruCache[string, *Team]
+}
+
+func newLRUBulkCache(client graphql.Client, cfg BulkCacheConfig) *lruBulkCache {
+	maxSize := cfg.LRUMaxSize
+	if maxSize <= 0 {
+		maxSize = defaultLRUMaxSize
+	}
+	return &lruBulkCache{
+		client:         client,
+		labels:         newLRUCache[string, *IssueLabel](maxSize),
+		workflowStates: newLRUCache[string, *WorkflowState](maxSize),
+		templates:      newLRUCache[string, *Template](maxSize),
+		teams:          newLRUCache[string, *Team](maxSize),
+	}
+}
+
+func (c *lruBulkCache) GetLabel(ctx context.Context, id string) (*IssueLabel, error) {
+	if label, ok := c.labels.Get(id); ok {
+		return label, nil
+	}
+	resp, err := getLabelById(ctx, c.client, id)
+	if err != nil {
+		return nil, fmt.Errorf("label not found: %s: %w", id, err)
+	}
+	label := &resp.IssueLabel
+	c.labels.Put(id, label)
+	return label, nil
+}
+
+func (c *lruBulkCache) ensureWorkflowStateExcerpts(ctx context.Context) {
+	c.workflowStateExcerptsOnce.Do(func() {
+		tflog.Debug(ctx, "bulk fetching workflow state excerpts")
+		c.workflowStateExcerpts = make(map[string]workflowStateExcerpt)
+		var cursor *string
+		for {
+			resp, err := listAllWorkflowStatesPage(ctx, c.client, cursor)
+			if err != nil {
+				c.workflowStateExcerptsErr = err
+				return
+			}
+			for _, node := range resp.WorkflowStates.Nodes {
+				ws := node.WorkflowState
+				c.workflowStateExcerpts[ws.Id] = workflowStateExcerpt{Id: ws.Id, TeamId: ws.Team.Id}
+			}
+			if !resp.WorkflowStates.PageInfo.HasNextPage {
+				break
+			}
+			cursor = &resp.WorkflowStates.PageInfo.EndCursor
+		}
+		tflog.Debug(ctx, fmt.Sprintf("bulk fetched %d workflow state excerpts", len(c.workflowStateExcerpts)))
+	})
+}
+
+func (c *lruBulkCache) GetWorkflowState(ctx context.Context, id string) (*WorkflowState, error) {
+	if ws, ok := c.workflowStates.Get(id); ok {
+		return ws, nil
+	}
+	resp, err := getWorkflowStateById(ctx, c.client, id)
+	if err != nil {
+		return nil, fmt.Errorf("workflow state not found: %s: %w", id, err)
+	}
+	ws := &resp.WorkflowState
+	c.workflowStates.Put(id, ws)
+	return ws, nil
+}
+
+func (c *lruBulkCache) GetWorkflowStatesByTeamID(ctx context.Context, teamID string) ([]WorkflowState, error) {
+	c.ensureWorkflowStateExcerpts(ctx)
+	if c.workflowStateExcerptsErr != nil {
+		return nil, c.workflowStateExcerptsErr
+	}
+
+	var result []WorkflowState
+	for id, excerpt := range c.workflowStateExcerpts {
+		if excerpt.TeamId != teamID {
+			continue
+		}
+		ws, err := c.GetWorkflowState(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *ws)
+	}
+	return result, nil
+}
+
+func (c *lruBulkCache) GetTemplate(ctx context.Context, id string) (*Template, error) {
+	if t, ok := c.templates.Get(id); ok {
+		return t, nil
+	}
+	resp, err := getTemplateById(ctx, c.client, id)
+	if err != nil {
+		return nil, fmt.Errorf("template not found: %s: %w", id, err)
+	}
+	t := &resp.Template
+	c.templates.Put(id, t)
+	return t, nil
+}
+
+func (c *lruBulkCache) GetTeamByKey(ctx context.Context, key string) (*Team, error) {
+	if team, ok := c.teams.Get(key); ok {
+		return team, nil
+	}
+	resp, err := getTeamByKey(ctx, c.client, key)
+	if err != nil {
+		return nil, fmt.Errorf("team not found: %s: %w", key, err)
+	}
+	team := &resp.Team
+	c.teams.Put(key, team)
+	return team, nil
+}
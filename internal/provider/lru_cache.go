@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"container/list"
+	"sync"
+)
+
+// lruCache is a minimal, mutex-guarded LRU keyed by comparable K storing
+// values of type V. It exists so eager and lru BulkCache modes can share
+// the same Get* signatures while lru mode bounds memory.
+type lruCache[K comparable, V any] struct {
+	mu      sync.Mutex
+	maxSize int
+	order   *list.List
+	items   map[K]*list.Element
+}
+
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+func newLRUCache[K comparable, V any](maxSize int) *lruCache[K, V] {
+	return &lruCache[K, V]{
+		maxSize: maxSize,
+		order:   list.New(),
+		items:   make(map[K]*list.Element),
+	}
+}
+
+func (c *lruCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*lruEntry[K, V]).value, true
+}
+
+func (c *lruCache[K, V]) Put(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		el.Value.(*lruEntry[K, V]).value = value
+		c.order.MoveToFront(el)
+		return
+	}
+
+	el := c.order.PushFront(&lruEntry[K, V]{key: key, value: value})
+	c.items[key] = el
+
+	if c.maxSize > 0 && c.order.Len() > c.maxSize {
+		oldest := c.order.Back()
+		if oldest != nil {
+			c.order.Remove(oldest)
+			delete(c.items, oldest.Value.(*lruEntry[K, V]).key)
+		}
+	}
+}
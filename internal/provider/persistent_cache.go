@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// persistentCacheSchemaVersion gates compatibility of on-disk snapshots.
+// Bump it whenever the shape written by persistentSnapshot.Data changes in
+// a way older snapshots can't be decoded into, so upgrades invalidate them
+// instead of failing to unmarshal.
+const persistentCacheSchemaVersion = 1
+
+// defaultRefreshInterval is how long a persisted snapshot is trusted before
+// ensureX re-fetches from the API, if the provider doesn't set one.
+const defaultRefreshInterval = time.Hour
+
+// persistentSnapshot is the on-disk envelope for one cached kind (labels,
+// workflow states, templates, or teams). Data is kept as raw JSON so this
+// file doesn't need to know the concrete map type being cached.
+type persistentSnapshot struct {
+	SchemaVersion int             `json:"schema_version"`
+	NextFetchTime time.Time       `json:"next_fetch_time"`
+	Data          json.RawMessage `json:"data"`
+}
+
+// diskCache reads and writes the persistent snapshot for each cache kind
+// under a shared directory, using a file lock so concurrent `terraform
+// apply` runs against the same workspace don't corrupt the store.
+type diskCache struct {
+	dir             string
+	refreshInterval time.Duration
+	disabled        bool
+}
+
+func newDiskCache(dir string, refreshInterval time.Duration, disabled bool) *diskCache {
+	if refreshInterval <= 0 {
+		refreshInterval = defaultRefreshInterval
+	}
+	return &diskCache{dir: dir, refreshInterval: refreshInterval, disabled: disabled}
+}
+
+func (d *diskCache) snapshotPath(kind string) string {
+	return filepath.Join(d.dir, kind+".json")
+}
+
+func (d *diskCache) lockPath(kind string) string {
+	return filepath.Join(d.dir, kind+".lock")
+}
+
+// load decodes the persisted snapshot for kind into out, reporting whether
+// it found one that's on the current schema version and hasn't passed its
+// next fetch time. Any other outcome (disabled, missing, stale, corrupt) is
+// treated as a cache miss so the caller falls back to the API.
+func (d *diskCache) load(ctx context.Context, kind string, out interface{}) bool {
+	if d.disabled {
+		return false
+	}
+
+	lock := flock.New(d.lockPath(kind))
+	locked, err := lock.TryRLock()
+	if err != nil || !locked {
+		return false
+	}
+	defer lock.Unlock()
+
+	raw, err := os.ReadFile(d.snapshotPath(kind))
+	if err != nil {
+		return false
+	}
+
+	var snap persistentSnapshot
+	if err := json.Unmarshal(raw, &snap); err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("discarding %s cache snapshot: %s", kind, err))
+		return false
+	}
+	if snap.SchemaVersion != persistentCacheSchemaVersion {
+		tflog.Debug(ctx, fmt.Sprintf("discarding %s cache snapshot: schema version %d (want %d)", kind, snap.SchemaVersion, persistentCacheSchemaVersion))
+		return false
+	}
+	if time.Now().After(snap.NextFetchTime) {
+		tflog.Debug(ctx, fmt.Sprintf("%s cache snapshot expired at %s", kind, snap.NextFetchTime))
+		return false
+	}
+	if err := json.Unmarshal(snap.Data, out); err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("discarding %s cache snapshot: %s", kind, err))
+		return false
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("loaded %s from persistent cache, next refresh at %s", kind, snap.NextFetchTime))
+	return true
+}
+
+// save writes data as the new snapshot for kind, stamping NextFetchTime as
+// now plus the configured refresh interval.
+func (d *diskCache) save(ctx context.Context, kind string, data interface{}) {
+	if d.disabled {
+		return
+	}
+	if err := os.MkdirAll(d.dir, 0o755); err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("could not create persistent cache dir %s: %s", d.dir, err))
+		return
+	}
+
+	lock := flock.New(d.lockPath(kind))
+	locked, err := lock.TryLock()
+	if err != nil || !locked {
+		tflog.Warn(ctx, fmt.Sprintf("could not lock %s cache snapshot for writing, skipping persist", kind))
+		return
+	}
+	defer lock.Unlock()
+
+	rawData, err := json.Marshal(data)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("could not marshal %s for persistent cache: %s", kind, err))
+		return
+	}
+	snap := persistentSnapshot{
+		SchemaVersion: persistentCacheSchemaVersion,
+		NextFetchTime: time.Now().Add(d.refreshInterval),
+		Data:          rawData,
+	}
+	raw, err := json.Marshal(snap)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("could not marshal %s cache snapshot: %s", kind, err))
+		return
+	}
+
+	tmp := d.snapshotPath(kind) + ".tmp"
+	if err := os.WriteFile(tmp, raw, 0o644); err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("could not write %s cache snapshot: %s", kind, err))
+		return
+	}
+	if err := os.Rename(tmp, d.snapshotPath(kind)); err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("could not persist %s cache snapshot: %s", kind, err))
+	}
+}
+
+// defaultCacheDir is used when the provider doesn't set cache_dir. workspace
+// scopes the store so multiple Linear workspaces configured on the same
+// machine don't share (and invalidate) each other's snapshots.
+func defaultCacheDir(workspace string) string {
+	home, err := os.UserHomeDir()
+	if err != nil || home == "" {
+		return filepath.Join(os.TempDir(), "terraform.d", "linear-cache", workspace)
+	}
+	return filepath.Join(home, ".terraform.d", "linear-cache", workspace)
+}